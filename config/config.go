@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config 应用配置，从 config.yaml 加载，env可覆盖关键字段
+type Config struct {
+	Database DatabaseConfig `yaml:"database"`
+}
+
+// DatabaseConfig 数据库连接配置
+type DatabaseConfig struct {
+	Driver          string `yaml:"driver"` // sqlite, mysql, postgres
+	Host            string `yaml:"host"`
+	Port            int    `yaml:"port"`
+	User            string `yaml:"user"`
+	Password        string `yaml:"password"`
+	DBName          string `yaml:"dbname"`
+	Charset         string `yaml:"charset"`
+	MaxIdleConns    int    `yaml:"max_idle_conns"`
+	MaxOpenConns    int    `yaml:"max_open_conns"`
+	ConnMaxLifetime int    `yaml:"conn_max_lifetime_minutes"`
+	LogLevel        string `yaml:"log_level"` // silent, error, warn, info
+}
+
+// defaultConfig 在没有config.yaml时使用，保持与历史行为一致(本地sqlite文件)
+func defaultConfig() *Config {
+	return &Config{
+		Database: DatabaseConfig{
+			Driver:          "sqlite",
+			DBName:          "students.db",
+			Charset:         "utf8mb4",
+			MaxIdleConns:    10,
+			MaxOpenConns:    100,
+			ConnMaxLifetime: 60,
+			LogLevel:        "info",
+		},
+	}
+}
+
+// LoadConfig 加载配置，优先读取config.yaml，再用环境变量覆盖数据库关键字段
+func LoadConfig() *Config {
+	cfg := defaultConfig()
+
+	if data, err := os.ReadFile("config.yaml"); err == nil {
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			panic("Failed to parse config.yaml: " + err.Error())
+		}
+	}
+
+	applyDatabaseEnvOverrides(&cfg.Database)
+
+	return cfg
+}
+
+func applyDatabaseEnvOverrides(db *DatabaseConfig) {
+	db.Driver = getEnv("DB_DRIVER", db.Driver)
+	db.Host = getEnv("DB_HOST", db.Host)
+	db.Port = getEnvAsInt("DB_PORT", db.Port)
+	db.User = getEnv("DB_USER", db.User)
+	db.Password = getEnv("DB_PASSWORD", db.Password)
+	db.DBName = getEnv("DB_NAME", db.DBName)
+	db.Charset = getEnv("DB_CHARSET", db.Charset)
+	db.LogLevel = getEnv("DB_LOG_LEVEL", db.LogLevel)
+}
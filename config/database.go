@@ -1,9 +1,15 @@
 package config
 
 import (
+	"fmt"
 	"log"
+	"time"
+
 	"student-management-system/models"
 
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -13,27 +19,99 @@ var DB *gorm.DB
 
 // InitDatabase 初始化数据库连接
 func InitDatabase() {
-	var err error
-	
-	// 连接SQLite数据库
-	DB, err = gorm.Open(sqlite.Open("students.db"), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+	cfg := LoadConfig()
+
+	dialector, err := newDialector(cfg.Database)
+	if err != nil {
+		log.Fatal("Failed to build database dialector:", err)
+	}
+
+	DB, err = gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logLevel(cfg.Database.LogLevel)),
 	})
-	
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
-	
+
+	sqlDB, err := DB.DB()
+	if err != nil {
+		log.Fatal("Failed to get underlying *sql.DB:", err)
+	}
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.Database.ConnMaxLifetime) * time.Minute)
+
 	// 自动迁移数据库表
-	err = DB.AutoMigrate(&models.Student{})
+	err = DB.AutoMigrate(&models.Student{}, &models.User{}, &models.AuditLog{})
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
-	
-	log.Println("Database connected and migrated successfully")
+
+	seedAdmin()
+
+	log.Printf("Database connected (driver: %s) and migrated successfully\n", cfg.Database.Driver)
+}
+
+// newDialector 根据配置的driver返回对应的gorm.Dialector
+func newDialector(db DatabaseConfig) (gorm.Dialector, error) {
+	switch db.Driver {
+	case "mysql":
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=True&loc=Local",
+			db.User, db.Password, db.Host, db.Port, db.DBName, db.Charset)
+		return mysql.Open(dsn), nil
+	case "postgres":
+		dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+			db.Host, db.Port, db.User, db.Password, db.DBName)
+		return postgres.Open(dsn), nil
+	case "sqlite", "":
+		return sqlite.Open(db.DBName), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", db.Driver)
+	}
+}
+
+// logLevel 将字符串日志级别转换为gorm的logger.LogLevel
+func logLevel(level string) logger.LogLevel {
+	switch level {
+	case "silent":
+		return logger.Silent
+	case "error":
+		return logger.Error
+	case "warn":
+		return logger.Warn
+	default:
+		return logger.Info
+	}
+}
+
+// seedAdmin 首次迁移时创建初始管理员账号
+func seedAdmin() {
+	var count int64
+	DB.Model(&models.User{}).Where("role = ?", models.RoleAdmin).Count(&count)
+	if count > 0 {
+		return
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("admin123"), bcrypt.DefaultCost)
+	if err != nil {
+		log.Println("Failed to hash initial admin password:", err)
+		return
+	}
+
+	admin := models.User{
+		Username: "admin",
+		Password: string(hashed),
+		Role:     models.RoleAdmin,
+	}
+	if result := DB.Create(&admin); result.Error != nil {
+		log.Println("Failed to seed initial admin:", result.Error)
+		return
+	}
+
+	log.Println("Seeded initial admin user (username: admin, password: admin123)")
 }
 
 // GetDB 获取数据库实例
 func GetDB() *gorm.DB {
 	return DB
-}
\ No newline at end of file
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// JWTSecret 签发/校验token使用的密钥
+var JWTSecret = []byte(getEnv("JWT_SECRET", "student-management-system-secret"))
+
+// JWTExpireHours access token有效期(小时)
+var JWTExpireHours = getEnvAsInt("JWT_EXPIRE_HOURS", 24)
+
+// JWTRefreshExpireHours refresh token有效期(小时)
+var JWTRefreshExpireHours = getEnvAsInt("JWT_REFRESH_EXPIRE_HOURS", 24*7)
+
+// JWTExpireDuration access token有效期
+func JWTExpireDuration() time.Duration {
+	return time.Duration(JWTExpireHours) * time.Hour
+}
+
+// JWTRefreshExpireDuration refresh token有效期
+func JWTRefreshExpireDuration() time.Duration {
+	return time.Duration(JWTRefreshExpireHours) * time.Hour
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvAsInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"strconv"
+
+	"student-management-system/pkg/errs"
+	"student-management-system/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditController 提供审计日志查询接口
+type AuditController struct {
+	service *services.AuditService
+}
+
+// NewAuditController 创建AuditController
+func NewAuditController(service *services.AuditService) *AuditController {
+	return &AuditController{service: service}
+}
+
+// GetAuditLogs 按实体类型和ID查询审计日志，entity_id为0时返回该实体下所有记录
+func (ac *AuditController) GetAuditLogs(c *gin.Context) {
+	entity := c.Query("entity")
+	if entity == "" {
+		errs.Fail(c, errs.ErrValidation("entity参数不能为空"))
+		return
+	}
+
+	var entityID uint
+	if idParam := c.Query("entity_id"); idParam != "" {
+		id, err := strconv.ParseUint(idParam, 10, 32)
+		if err != nil {
+			errs.Fail(c, errs.ErrValidation("Invalid entity_id"))
+			return
+		}
+		entityID = uint(id)
+	}
+
+	logs, err := ac.service.ListByEntity(entity, entityID)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.OK(c, gin.H{"list": logs})
+}
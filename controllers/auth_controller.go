@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"student-management-system/config"
+	"student-management-system/middleware"
+	"student-management-system/models"
+	"student-management-system/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type AuthController struct{}
+
+// RegisterReq 自助注册请求参数，角色固定为student，创建admin/teacher账号需由管理员调用CreateUser
+type RegisterReq struct {
+	Username  string `json:"username" binding:"required"`
+	Password  string `json:"password" binding:"required,min=6"`
+	StudentID *uint  `json:"student_id"`
+}
+
+// CreateUserReq 管理员创建账号请求参数，可指定任意角色
+type CreateUserReq struct {
+	Username  string `json:"username" binding:"required"`
+	Password  string `json:"password" binding:"required,min=6"`
+	Role      string `json:"role" binding:"required,oneof=admin teacher student"`
+	StudentID *uint  `json:"student_id"`
+}
+
+// LoginReq 登录请求参数
+type LoginReq struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshReq 刷新token请求参数
+type RefreshReq struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutReq 登出请求参数，refresh_token可选，传入时一并加入黑名单
+type LogoutReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// createUser 校验用户名唯一性、加密密码并写入用户记录
+func createUser(c *gin.Context, username, password, role string, studentID *uint) {
+	db := config.GetDB()
+
+	var count int64
+	db.Model(&models.User{}).Where("username = ?", username).Count(&count)
+	if count > 0 {
+		errs.Fail(c, errs.ErrConflict("用户名已存在"))
+		return
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	user := models.User{
+		Username:  username,
+		Password:  string(hashed),
+		Role:      role,
+		StudentID: studentID,
+	}
+
+	if result := db.Create(&user); result.Error != nil {
+		errs.Fail(c, result.Error)
+		return
+	}
+
+	errs.Created(c, user)
+}
+
+// Register 用户自助注册，角色固定为student，避免匿名用户自行授予admin/teacher权限
+func (ac *AuthController) Register(c *gin.Context) {
+	var req RegisterReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	createUser(c, req.Username, req.Password, models.RoleStudent, req.StudentID)
+}
+
+// CreateUser 管理员创建账号，可指定admin/teacher/student任意角色
+func (ac *AuthController) CreateUser(c *gin.Context) {
+	var req CreateUserReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	createUser(c, req.Username, req.Password, req.Role, req.StudentID)
+}
+
+// Login 用户登录，成功后返回access token和refresh token
+func (ac *AuthController) Login(c *gin.Context) {
+	var req LoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	db := config.GetDB()
+
+	var user models.User
+	if result := db.Where("username = ?", req.Username).First(&user); result.Error != nil {
+		errs.Fail(c, errs.ErrUnauthorized("用户名或密码错误"))
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		errs.Fail(c, errs.ErrUnauthorized("用户名或密码错误"))
+		return
+	}
+
+	accessToken, err := middleware.GenerateToken(user.ID, user.Username, user.Role)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	refreshToken, err := middleware.GenerateRefreshToken(user.ID, user.Username, user.Role)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.OK(c, gin.H{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"user":          user,
+	})
+}
+
+// Refresh 使用refresh token换取新的access token
+func (ac *AuthController) Refresh(c *gin.Context) {
+	var req RefreshReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	if middleware.IsBlacklisted(req.RefreshToken) {
+		errs.Fail(c, errs.ErrUnauthorized("token已失效"))
+		return
+	}
+
+	claims, err := middleware.ParseToken(req.RefreshToken)
+	if err != nil {
+		errs.Fail(c, errs.ErrUnauthorized("refresh token无效或已过期"))
+		return
+	}
+
+	accessToken, err := middleware.GenerateToken(claims.UserID, claims.Username, claims.Role)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.OK(c, gin.H{
+		"access_token": accessToken,
+	})
+}
+
+// Logout 登出，将当前access token和请求体中的refresh token一并加入黑名单，避免旧refresh token继续换取access token
+func (ac *AuthController) Logout(c *gin.Context) {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		token := authHeader
+		const prefix = "Bearer "
+		if len(token) > len(prefix) {
+			token = token[len(prefix):]
+		}
+		middleware.BlacklistToken(token)
+	}
+
+	var req LogoutReq
+	if err := c.ShouldBindJSON(&req); err == nil && req.RefreshToken != "" {
+		middleware.BlacklistToken(req.RefreshToken)
+	}
+
+	errs.OK(c, nil)
+}
@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"student-management-system/dto"
+	"student-management-system/models"
+	"student-management-system/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+)
+
+// DeleteReq 批量删除请求参数
+type DeleteReq struct {
+	IDs []uint `json:"ids" binding:"required"`
+}
+
+var studentCSVHeader = []string{"name", "age", "gender", "email", "phone", "major", "grade"}
+
+// BulkCreateStudents 批量创建学生
+func (sc *StudentController) BulkCreateStudents(c *gin.Context) {
+	var students []models.Student
+	if err := c.ShouldBindJSON(&students); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	inserted, rowErrors, err := sc.service.BulkCreate(students, actorID(c), c.ClientIP())
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.Created(c, gin.H{
+		"inserted": inserted,
+		"skipped":  len(rowErrors),
+		"errors":   rowErrors,
+	})
+}
+
+// BulkDeleteStudents 批量删除学生，ids以逗号分隔
+func (sc *StudentController) BulkDeleteStudents(c *gin.Context) {
+	idsParam := c.Query("ids")
+	if idsParam == "" {
+		errs.Fail(c, errs.ErrValidation("ids参数不能为空"))
+		return
+	}
+
+	var req DeleteReq
+	for _, part := range strings.Split(idsParam, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			errs.Fail(c, errs.ErrValidation("Invalid student ID in ids"))
+			return
+		}
+		req.IDs = append(req.IDs, uint(id))
+	}
+
+	if len(req.IDs) == 0 {
+		errs.Fail(c, errs.ErrValidation("ids参数不能为空"))
+		return
+	}
+
+	deleted, err := sc.service.BulkDelete(req.IDs, actorID(c), c.ClientIP())
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.OK(c, gin.H{
+		"deleted": deleted,
+	})
+}
+
+// ImportStudents 从上传的CSV或XLSX文件导入学生，按email去重
+func (sc *StudentController) ImportStudents(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		errs.Fail(c, errs.ErrValidation("Failed to read uploaded file: "+err.Error()))
+		return
+	}
+	defer file.Close()
+
+	rows, err := parseImportFile(file, header)
+	if err != nil {
+		errs.Fail(c, errs.ErrValidation("Failed to parse uploaded file: "+err.Error()))
+		return
+	}
+
+	inserted, updated, skipped, rowErrors, err := sc.service.Import(rows, actorID(c), c.ClientIP())
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	errs.OK(c, gin.H{
+		"inserted": inserted,
+		"updated":  updated,
+		"skipped":  skipped,
+		"errors":   rowErrors,
+	})
+}
+
+// ExportStudents 导出当前筛选条件下的学生列表为CSV或XLSX
+func (sc *StudentController) ExportStudents(c *gin.Context) {
+	format := c.DefaultQuery("format", "csv")
+
+	var query dto.StudentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	students, err := sc.service.ExportAll(&query)
+	if err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	switch format {
+	case "xlsx":
+		exportXLSX(c, students)
+	case "csv":
+		exportCSV(c, students)
+	default:
+		errs.Fail(c, errs.ErrValidation("format必须为 csv 或 xlsx"))
+	}
+}
+
+// parseImportFile 根据文件扩展名解析CSV或XLSX，返回以header为key的行数据
+func parseImportFile(file multipart.File, header *multipart.FileHeader) ([]map[string]string, error) {
+	if strings.HasSuffix(strings.ToLower(header.Filename), ".xlsx") {
+		return parseXLSXRows(file)
+	}
+	return parseCSVRows(file)
+}
+
+func parseCSVRows(file multipart.File) ([]map[string]string, error) {
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("文件为空")
+	}
+
+	return recordsToRows(records), nil
+}
+
+func parseXLSXRows(file multipart.File) ([]map[string]string, error) {
+	f, err := excelize.OpenReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sheet := f.GetSheetList()[0]
+	records, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("文件为空")
+	}
+
+	return recordsToRows(records), nil
+}
+
+func recordsToRows(records [][]string) []map[string]string {
+	cols := records[0]
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(cols))
+		for i, col := range cols {
+			if i < len(record) {
+				row[strings.TrimSpace(col)] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func exportCSV(c *gin.Context, students []models.Student) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write(studentCSVHeader)
+	for _, s := range students {
+		_ = writer.Write([]string{
+			s.Name, strconv.Itoa(s.Age), s.Gender, s.Email, s.Phone, s.Major, s.Grade,
+		})
+	}
+	writer.Flush()
+
+	c.Header("Content-Disposition", "attachment; filename=students.csv")
+	c.Data(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+func exportXLSX(c *gin.Context, students []models.Student) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := "Students"
+	f.SetSheetName(f.GetSheetName(0), sheet)
+
+	for i, col := range studentCSVHeader {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, col)
+	}
+
+	for i, s := range students {
+		row := i + 2
+		values := []interface{}{s.Name, s.Age, s.Gender, s.Email, s.Phone, s.Major, s.Grade}
+		for j, v := range values {
+			cell, _ := excelize.CoordinatesToCellName(j+1, row)
+			f.SetCellValue(sheet, cell, v)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=students.xlsx")
+	c.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
+}
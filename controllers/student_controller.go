@@ -1,34 +1,73 @@
 package controllers
 
 import (
-	"net/http"
+	"errors"
 	"strconv"
-	"student-management-system/config"
+
+	"student-management-system/dto"
 	"student-management-system/models"
+	"student-management-system/pkg/errs"
+	"student-management-system/services"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
-type StudentController struct{}
+// actorID 从上下文中取出当前登录用户ID，用于审计日志
+func actorID(c *gin.Context) uint {
+	id, _ := c.Get("user_id")
+	userID, _ := id.(uint)
+	return userID
+}
+
+// StudentController 处理学生相关的HTTP请求，具体业务逻辑交给StudentService/UserService
+type StudentController struct {
+	service     *services.StudentService
+	userService *services.UserService
+}
+
+// NewStudentController 创建StudentController
+func NewStudentController(service *services.StudentService, userService *services.UserService) *StudentController {
+	return &StudentController{service: service, userService: userService}
+}
+
+// canAccessStudent 校验student角色是否只能访问自己关联的学生记录
+func (sc *StudentController) canAccessStudent(c *gin.Context, studentID uint) bool {
+	role, _ := c.Get("role")
+	if role != models.RoleStudent {
+		return true
+	}
+
+	user, err := sc.userService.GetByID(actorID(c))
+	if err != nil {
+		return false
+	}
 
-// GetAllStudents 获取所有学生
+	return user.StudentID != nil && *user.StudentID == studentID
+}
+
+// GetAllStudents 获取学生列表，支持分页、排序和过滤
 func (sc *StudentController) GetAllStudents(c *gin.Context) {
-	var students []models.Student
-	db := config.GetDB()
-	
-	// 确保只获取未删除的记录
-	result := db.Where("deleted_at IS NULL").Find(&students)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to fetch students",
-			"message": result.Error.Error(),
-		})
+	var query dto.StudentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errs.Fail(c, err)
+		return
+	}
+
+	students, total, err := sc.service.List(&query)
+	if err != nil {
+		errs.Fail(c, err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{
-		"data": students,
-		"count": len(students),
+
+	totalPages := int((total + int64(query.PageSize) - 1) / int64(query.PageSize))
+
+	errs.OK(c, gin.H{
+		"list":        students,
+		"total":       total,
+		"page":        query.Page,
+		"page_size":   query.PageSize,
+		"total_pages": totalPages,
 	})
 }
 
@@ -36,173 +75,116 @@ func (sc *StudentController) GetAllStudents(c *gin.Context) {
 func (sc *StudentController) GetStudentByID(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid student ID",
-		})
+		errs.Fail(c, errs.ErrValidation("Invalid student ID"))
 		return
 	}
-	
-	var student models.Student
-	db := config.GetDB()
-	
-	result := db.First(&student, uint(id))
-	if result.Error != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Student not found",
-		})
+
+	if !sc.canAccessStudent(c, uint(id)) {
+		errs.Fail(c, errs.ErrForbidden("没有权限查看该学生记录"))
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{
-		"data": student,
-	})
+
+	student, err := sc.service.GetByID(uint(id))
+	if err != nil {
+		errs.Fail(c, errs.ErrNotFound("Student not found"))
+		return
+	}
+
+	errs.OK(c, student)
 }
 
 // CreateStudent 创建新学生
 func (sc *StudentController) CreateStudent(c *gin.Context) {
 	var student models.Student
-	
+
 	if err := c.ShouldBindJSON(&student); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid input data",
-			"message": err.Error(),
-		})
+		errs.Fail(c, err)
 		return
 	}
-	
-	db := config.GetDB()
-	result := db.Create(&student)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to create student",
-			"message": result.Error.Error(),
-		})
+
+	if err := sc.service.Create(&student, actorID(c), c.ClientIP()); err != nil {
+		errs.Fail(c, err)
 		return
 	}
-	
-	c.JSON(http.StatusCreated, gin.H{
-		"message": "Student created successfully",
-		"data": student,
-	})
+
+	errs.Created(c, student)
 }
 
 // UpdateStudent 更新学生信息
 func (sc *StudentController) UpdateStudent(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid student ID",
-		})
+		errs.Fail(c, errs.ErrValidation("Invalid student ID"))
 		return
 	}
-	
-	var student models.Student
-	db := config.GetDB()
-	
-	// 检查学生是否存在
-	result := db.First(&student, uint(id))
-	if result.Error != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Student not found",
-		})
-		return
-	}
-	
-	// 绑定更新数据
+
 	var updateData models.Student
 	if err := c.ShouldBindJSON(&updateData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid input data",
-			"message": err.Error(),
-		})
+		errs.Fail(c, err)
 		return
 	}
-	
-	// 更新学生信息
-	result = db.Model(&student).Updates(updateData)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to update student",
-			"message": result.Error.Error(),
-		})
+
+	student, err := sc.service.Update(uint(id), updateData, actorID(c), c.ClientIP())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errs.Fail(c, errs.ErrNotFound("Student not found"))
+			return
+		}
+		errs.Fail(c, err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Student updated successfully",
-		"data": student,
-	})
+
+	errs.OK(c, student)
 }
 
 // DeleteStudent 删除学生
 func (sc *StudentController) DeleteStudent(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid student ID",
-		})
+		errs.Fail(c, errs.ErrValidation("Invalid student ID"))
 		return
 	}
-	
-	var student models.Student
-	db := config.GetDB()
-	
-	// 检查学生是否存在
-	result := db.First(&student, uint(id))
-	if result.Error != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Student not found",
-		})
+
+	if err := sc.service.Delete(uint(id), actorID(c), c.ClientIP()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errs.Fail(c, errs.ErrNotFound("Student not found"))
+			return
+		}
+		errs.Fail(c, err)
 		return
 	}
-	
-	// 软删除学生
-	result = db.Delete(&student)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to delete student",
-			"message": result.Error.Error(),
-		})
+
+	errs.OK(c, nil)
+}
+
+// GetTrashedStudents 获取已软删除的学生列表
+func (sc *StudentController) GetTrashedStudents(c *gin.Context) {
+	students, err := sc.service.ListTrashed()
+	if err != nil {
+		errs.Fail(c, err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Student deleted successfully",
-	})
+
+	errs.OK(c, gin.H{"list": students})
 }
 
-// SearchStudents 搜索学生
-func (sc *StudentController) SearchStudents(c *gin.Context) {
-	name := c.Query("name")
-	major := c.Query("major")
-	grade := c.Query("grade")
-	
-	var students []models.Student
-	db := config.GetDB()
-	// 确保只搜索未删除的记录
-	query := db.Where("deleted_at IS NULL")
-	
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
-	}
-	if major != "" {
-		query = query.Where("major LIKE ?", "%"+major+"%")
-	}
-	if grade != "" {
-		query = query.Where("grade = ?", grade)
-	}
-	
-	result := query.Find(&students)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to search students",
-			"message": result.Error.Error(),
-		})
+// RestoreStudent 恢复一条已软删除的学生记录
+func (sc *StudentController) RestoreStudent(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		errs.Fail(c, errs.ErrValidation("Invalid student ID"))
+		return
+	}
+
+	student, err := sc.service.Restore(uint(id), actorID(c), c.ClientIP())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errs.Fail(c, errs.ErrNotFound("Trashed student not found"))
+			return
+		}
+		errs.Fail(c, err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{
-		"data": students,
-		"count": len(students),
-	})
-}
\ No newline at end of file
+
+	errs.OK(c, student)
+}
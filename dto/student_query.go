@@ -0,0 +1,57 @@
+package dto
+
+// StudentQuery 学生列表查询参数
+type StudentQuery struct {
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort     string `form:"sort"`
+
+	Name   string `form:"name"`
+	Major  string `form:"major"`
+	Grade  string `form:"grade"`
+	Gender string `form:"gender"`
+	AgeMin int    `form:"age_min" binding:"omitempty,min=0"`
+	AgeMax int    `form:"age_max" binding:"omitempty,min=0"`
+	Q      string `form:"q"`
+}
+
+// studentSortColumns 允许排序的字段白名单
+var studentSortColumns = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"age":        true,
+	"created_at": true,
+}
+
+// Normalize 填充分页默认值并限制page_size上限
+func (q *StudentQuery) Normalize() {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = 10
+	}
+	if q.PageSize > 100 {
+		q.PageSize = 100
+	}
+}
+
+// Offset 返回分页查询的偏移量
+func (q *StudentQuery) Offset() int {
+	return (q.Page - 1) * q.PageSize
+}
+
+// OrderClause 将sort参数转换为合法的ORDER BY子句，非法字段时返回默认排序
+func (q *StudentQuery) OrderClause() string {
+	column := q.Sort
+	direction := "ASC"
+	if len(column) > 0 && column[0] == '-' {
+		direction = "DESC"
+		column = column[1:]
+	}
+
+	if !studentSortColumns[column] {
+		return "id ASC"
+	}
+	return column + " " + direction
+}
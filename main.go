@@ -3,7 +3,11 @@ package main
 import (
 	"log"
 	"student-management-system/config"
+	"student-management-system/controllers"
+	"student-management-system/middleware"
+	"student-management-system/repositories"
 	"student-management-system/routes"
+	"student-management-system/services"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,42 +15,67 @@ import (
 func main() {
 	// 初始化数据库
 	config.InitDatabase()
-	
-	// 创建Gin路由器
-	router := gin.Default()
-	
+
+	// 组装repository/service/controller依赖
+	studentRepo := repositories.NewStudentRepository()
+	userRepo := repositories.NewUserRepository()
+	auditLogRepo := repositories.NewAuditLogRepository()
+	auditService := services.NewAuditService(config.GetDB(), auditLogRepo)
+	studentService := services.NewStudentService(config.GetDB(), studentRepo, auditService)
+	userService := services.NewUserService(config.GetDB(), userRepo)
+	studentController := controllers.NewStudentController(studentService, userService)
+	auditController := controllers.NewAuditController(auditService)
+
+	// 创建Gin路由器，使用自定义Recovery返回统一错误响应，而不是Gin默认的HTML错误页
+	router := gin.New()
+	router.Use(gin.Logger())
+	router.Use(middleware.Recovery())
+	router.Use(middleware.RequestID())
+	router.Use(middleware.ErrorHandler())
+
 	// 添加CORS中间件
 	router.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		
+
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
 		}
-		
+
 		c.Next()
 	})
-	
+
 	// 设置路由
-	routes.SetupRoutes(router)
-	
+	routes.SetupRoutes(router, studentController, auditController)
+
 	// 启动服务器
 	log.Println("Starting server on :8080...")
 	log.Println("API Documentation:")
-	log.Println("GET    /api/v1/students        - 获取所有学生")
-	log.Println("GET    /api/v1/students/search - 搜索学生 (参数: name, major, grade)")
+	log.Println("POST   /api/v1/auth/register   - 用户注册")
+	log.Println("POST   /api/v1/auth/login      - 用户登录")
+	log.Println("POST   /api/v1/auth/refresh    - 刷新token")
+	log.Println("POST   /api/v1/auth/logout     - 用户登出")
+	log.Println("POST   /api/v1/auth/users      - 管理员创建账号 (仅admin)")
+	log.Println("GET    /api/v1/students        - 获取学生列表 (参数: page, page_size, sort, name, major, grade, gender, age_min, age_max, q)")
+	log.Println("GET    /api/v1/students/export  - 导出学生列表 (参数: format=csv|xlsx)")
 	log.Println("GET    /api/v1/students/:id    - 根据ID获取学生")
 	log.Println("POST   /api/v1/students        - 创建新学生")
+	log.Println("POST   /api/v1/students/bulk    - 批量创建学生")
+	log.Println("POST   /api/v1/students/import  - 从CSV/XLSX导入学生")
 	log.Println("PUT    /api/v1/students/:id    - 更新学生信息")
 	log.Println("DELETE /api/v1/students/:id    - 删除学生")
+	log.Println("DELETE /api/v1/students/bulk    - 批量删除学生 (参数: ids=1,2,3)")
+	log.Println("GET    /api/v1/students/trash   - 查看已软删除的学生")
+	log.Println("POST   /api/v1/students/:id/restore - 恢复已软删除的学生")
+	log.Println("GET    /api/v1/audit            - 查询审计日志 (参数: entity, entity_id)")
 	log.Println("")
 	log.Println("Web Interface:")
 	log.Println("http://localhost:8080          - 首页")
 	log.Println("http://localhost:8080/students - 学生管理页面")
-	
+
 	if err := router.Run(":8080"); err != nil {
 		log.Fatal("Failed to start server:", err)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"student-management-system/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler 统一处理handler通过c.Error()记录但尚未写响应的错误
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Written() || len(c.Errors) == 0 {
+			return
+		}
+
+		errs.Fail(c, c.Errors.Last().Err)
+	}
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"student-management-system/config"
+	"student-management-system/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenBlacklist 记录已登出的token，避免被继续使用
+var (
+	tokenBlacklist   = make(map[string]struct{})
+	tokenBlacklistMu sync.Mutex
+)
+
+// BlacklistToken 将token加入黑名单
+func BlacklistToken(token string) {
+	tokenBlacklistMu.Lock()
+	defer tokenBlacklistMu.Unlock()
+	tokenBlacklist[token] = struct{}{}
+}
+
+// IsBlacklisted 判断token是否已登出
+func IsBlacklisted(token string) bool {
+	tokenBlacklistMu.Lock()
+	defer tokenBlacklistMu.Unlock()
+	_, ok := tokenBlacklist[token]
+	return ok
+}
+
+// Claims 自定义JWT声明
+type Claims struct {
+	UserID   uint   `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// GenerateToken 生成access token
+func GenerateToken(userID uint, username, role string) (string, error) {
+	return generateToken(userID, username, role, config.JWTExpireDuration())
+}
+
+// GenerateRefreshToken 生成refresh token
+func GenerateRefreshToken(userID uint, username, role string) (string, error) {
+	return generateToken(userID, username, role, config.JWTRefreshExpireDuration())
+}
+
+func generateToken(userID uint, username, role string, expire time.Duration) (string, error) {
+	claims := Claims{
+		UserID:   userID,
+		Username: username,
+		Role:     role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expire)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(config.JWTSecret)
+}
+
+// ParseToken 解析并校验token
+func ParseToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return config.JWTSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+// JWTAuth 校验请求携带的JWT，并将用户信息写入上下文
+func JWTAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			errs.Fail(c, errs.ErrUnauthorized("缺少Authorization请求头"))
+			c.Abort()
+			return
+		}
+
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			errs.Fail(c, errs.ErrUnauthorized("Authorization请求头格式错误"))
+			c.Abort()
+			return
+		}
+
+		if IsBlacklisted(parts[1]) {
+			errs.Fail(c, errs.ErrUnauthorized("token已失效"))
+			c.Abort()
+			return
+		}
+
+		claims, err := ParseToken(parts[1])
+		if err != nil {
+			errs.Fail(c, errs.ErrUnauthorized("token无效或已过期"))
+			c.Abort()
+			return
+		}
+
+		c.Set("user_id", claims.UserID)
+		c.Set("username", claims.Username)
+		c.Set("role", claims.Role)
+		c.Next()
+	}
+}
+
+// RequireRole 校验当前用户角色是否在允许列表中
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, exists := c.Get("role")
+		if !exists {
+			errs.Fail(c, errs.ErrUnauthorized("未认证"))
+			c.Abort()
+			return
+		}
+
+		for _, r := range roles {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+
+		errs.Fail(c, errs.ErrForbidden("没有权限执行此操作"))
+		c.Abort()
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"log"
+	"runtime/debug"
+
+	"student-management-system/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery 捕获panic，记录堆栈并返回统一错误响应，替代Gin默认的HTML错误页
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic recovered: %v\n%s", r, debug.Stack())
+				errs.Fail(c, errs.ErrInternal("服务器内部错误"))
+				c.Abort()
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// 审计动作
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+)
+
+// AuditLog 记录对业务实体的增删改操作
+type AuditLog struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ActorUserID uint      `json:"actor_user_id" gorm:"index"`
+	Action      string    `json:"action" gorm:"size:20;not null"`
+	Entity      string    `json:"entity" gorm:"size:50;not null;index"`
+	EntityID    uint      `json:"entity_id" gorm:"index"`
+	Before      string    `json:"before" gorm:"type:text"`
+	After       string    `json:"after" gorm:"type:text"`
+	IP          string    `json:"ip" gorm:"size:50"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
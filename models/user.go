@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 用户角色
+const (
+	RoleAdmin   = "admin"
+	RoleTeacher = "teacher"
+	RoleStudent = "student"
+)
+
+// User 用户模型
+type User struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	Username  string         `json:"username" gorm:"uniqueIndex;size:50;not null" binding:"required"`
+	Password  string         `json:"-" gorm:"size:100;not null"`
+	Role      string         `json:"role" gorm:"size:20;not null" binding:"required,oneof=admin teacher student"`
+	StudentID *uint          `json:"student_id" gorm:"index"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// TableName 指定表名
+func (User) TableName() string {
+	return "users"
+}
@@ -0,0 +1,55 @@
+package errs
+
+import "net/http"
+
+// 业务错误码
+const (
+	CodeSuccess      = 0
+	CodeValidation   = 1001
+	CodeNotFound     = 1002
+	CodeConflict     = 1003
+	CodeUnauthorized = 1004
+	CodeForbidden    = 1005
+	CodeInternal     = 1006
+)
+
+// AppError 携带业务码和HTTP状态的错误类型
+type AppError struct {
+	Code    int
+	Status  int
+	Message string
+}
+
+func (e *AppError) Error() string {
+	return e.Message
+}
+
+// ErrValidation 参数校验失败，对应400
+func ErrValidation(message string) *AppError {
+	return &AppError{Code: CodeValidation, Status: http.StatusBadRequest, Message: message}
+}
+
+// ErrNotFound 资源不存在，对应404
+func ErrNotFound(message string) *AppError {
+	return &AppError{Code: CodeNotFound, Status: http.StatusNotFound, Message: message}
+}
+
+// ErrConflict 资源冲突，对应409
+func ErrConflict(message string) *AppError {
+	return &AppError{Code: CodeConflict, Status: http.StatusConflict, Message: message}
+}
+
+// ErrUnauthorized 未认证，对应401
+func ErrUnauthorized(message string) *AppError {
+	return &AppError{Code: CodeUnauthorized, Status: http.StatusUnauthorized, Message: message}
+}
+
+// ErrForbidden 无权限，对应403
+func ErrForbidden(message string) *AppError {
+	return &AppError{Code: CodeForbidden, Status: http.StatusForbidden, Message: message}
+}
+
+// ErrInternal 服务端内部错误，对应500
+func ErrInternal(message string) *AppError {
+	return &AppError{Code: CodeInternal, Status: http.StatusInternalServerError, Message: message}
+}
@@ -0,0 +1,61 @@
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// OK 返回统一成功响应，HTTP 200
+func OK(c *gin.Context, data interface{}) {
+	respond(c, http.StatusOK, CodeSuccess, "success", data)
+}
+
+// Created 返回统一成功响应，HTTP 201
+func Created(c *gin.Context, data interface{}) {
+	respond(c, http.StatusCreated, CodeSuccess, "success", data)
+}
+
+// Fail 将err转换为统一错误响应；JSON/bind语法错误按400处理，其余未知错误按内部错误处理，
+// 且不会把原始错误文本（如数据库驱动报错）透出给客户端，仅记录到服务端日志
+func Fail(c *gin.Context, err error) {
+	switch e := err.(type) {
+	case *AppError:
+		respond(c, e.Status, e.Code, e.Message, nil)
+	case validator.ValidationErrors:
+		message := TranslateValidationErrors(e)
+		respond(c, http.StatusBadRequest, CodeValidation, message, nil)
+	default:
+		if isBindError(err) {
+			respond(c, http.StatusBadRequest, CodeValidation, "请求参数格式错误", nil)
+			return
+		}
+		log.Printf("internal error: %v", err)
+		respond(c, http.StatusInternalServerError, CodeInternal, "服务器内部错误", nil)
+	}
+}
+
+// isBindError 判断是否为请求参数解析失败（请求体JSON语法错误、类型不匹配、空body，或query参数数值格式错误）
+func isBindError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var numErr *strconv.NumError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || errors.As(err, &numErr) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func respond(c *gin.Context, status, code int, message string, data interface{}) {
+	requestID, _ := c.Get("request_id")
+	c.JSON(status, gin.H{
+		"code":       code,
+		"message":    message,
+		"data":       data,
+		"request_id": requestID,
+	})
+}
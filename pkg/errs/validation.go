@@ -0,0 +1,32 @@
+package errs
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/zh"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	zhTranslations "github.com/go-playground/validator/v10/translations/zh"
+)
+
+var zhTrans ut.Translator
+
+func init() {
+	locale := zh.New()
+	uni := ut.New(locale, locale)
+	zhTrans, _ = uni.GetTranslator("zh")
+
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = zhTranslations.RegisterDefaultTranslations(v, zhTrans)
+	}
+}
+
+// TranslateValidationErrors 将validator.ValidationErrors翻译为中文，按字段拼接
+func TranslateValidationErrors(verrs validator.ValidationErrors) string {
+	messages := make([]string, 0, len(verrs))
+	for _, fe := range verrs {
+		messages = append(messages, fe.Translate(zhTrans))
+	}
+	return strings.Join(messages, "; ")
+}
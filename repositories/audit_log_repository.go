@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"student-management-system/models"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepo 定义AuditService依赖的数据访问方法，使单测可以注入mock实现
+type AuditLogRepo interface {
+	Create(db *gorm.DB, log *models.AuditLog) error
+	ListByEntity(db *gorm.DB, entity string, entityID uint) ([]models.AuditLog, error)
+}
+
+// AuditLogRepository 封装对audit_logs表的数据访问
+type AuditLogRepository struct{}
+
+var _ AuditLogRepo = (*AuditLogRepository)(nil)
+
+// NewAuditLogRepository 创建AuditLogRepository
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{}
+}
+
+// Create 写入一条审计日志，db可以是普通连接也可以是事务
+func (r *AuditLogRepository) Create(db *gorm.DB, log *models.AuditLog) error {
+	return db.Create(log).Error
+}
+
+// ListByEntity 按实体类型查询审计日志，entityID为0时不按具体ID过滤，按时间倒序
+func (r *AuditLogRepository) ListByEntity(db *gorm.DB, entity string, entityID uint) ([]models.AuditLog, error) {
+	scope := db.Where("entity = ?", entity)
+	if entityID > 0 {
+		scope = scope.Where("entity_id = ?", entityID)
+	}
+
+	var logs []models.AuditLog
+	err := scope.Order("created_at DESC").Find(&logs).Error
+	return logs, err
+}
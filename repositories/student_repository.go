@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"student-management-system/dto"
+	"student-management-system/models"
+
+	"gorm.io/gorm"
+)
+
+// StudentRepo 定义StudentService依赖的数据访问方法，使单测可以注入mock实现
+type StudentRepo interface {
+	Create(db *gorm.DB, student *models.Student) error
+	CreateInBatches(db *gorm.DB, students []models.Student, batchSize int) error
+	Update(db *gorm.DB, student *models.Student, updates models.Student) error
+	Delete(db *gorm.DB, student *models.Student) error
+	DeleteByIDs(db *gorm.DB, ids []uint) (int64, error)
+	FindExistingIDs(db *gorm.DB, ids []uint) ([]uint, error)
+	FindByID(db *gorm.DB, id uint) (*models.Student, error)
+	FindByEmail(db *gorm.DB, email string) (*models.Student, error)
+	ExistsByEmail(db *gorm.DB, email string) (bool, error)
+	List(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, int64, error)
+	FindFiltered(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, error)
+	FindTrashed(db *gorm.DB) ([]models.Student, error)
+	FindTrashedByID(db *gorm.DB, id uint) (*models.Student, error)
+	Restore(db *gorm.DB, id uint) error
+}
+
+// StudentRepository 封装对students表的数据访问
+type StudentRepository struct{}
+
+var _ StudentRepo = (*StudentRepository)(nil)
+
+// NewStudentRepository 创建StudentRepository
+func NewStudentRepository() *StudentRepository {
+	return &StudentRepository{}
+}
+
+// Create 创建学生，db可以是普通连接也可以是事务
+func (r *StudentRepository) Create(db *gorm.DB, student *models.Student) error {
+	return db.Create(student).Error
+}
+
+// CreateInBatches 分批创建学生
+func (r *StudentRepository) CreateInBatches(db *gorm.DB, students []models.Student, batchSize int) error {
+	if len(students) == 0 {
+		return nil
+	}
+	return db.CreateInBatches(&students, batchSize).Error
+}
+
+// Update 更新学生信息
+func (r *StudentRepository) Update(db *gorm.DB, student *models.Student, updates models.Student) error {
+	return db.Model(student).Updates(updates).Error
+}
+
+// Delete 软删除学生
+func (r *StudentRepository) Delete(db *gorm.DB, student *models.Student) error {
+	return db.Delete(student).Error
+}
+
+// DeleteByIDs 按ID批量软删除学生，返回受影响行数
+func (r *StudentRepository) DeleteByIDs(db *gorm.DB, ids []uint) (int64, error) {
+	result := db.Where("id IN ?", ids).Delete(&models.Student{})
+	return result.RowsAffected, result.Error
+}
+
+// FindExistingIDs 从给定ID中筛选出实际存在的学生ID，用于批量操作前确认目标确实存在
+func (r *StudentRepository) FindExistingIDs(db *gorm.DB, ids []uint) ([]uint, error) {
+	var existing []uint
+	if err := db.Model(&models.Student{}).Where("id IN ?", ids).Pluck("id", &existing).Error; err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// FindByID 根据ID查找学生
+func (r *StudentRepository) FindByID(db *gorm.DB, id uint) (*models.Student, error) {
+	var student models.Student
+	if err := db.First(&student, id).Error; err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
+
+// FindByEmail 根据email查找学生
+func (r *StudentRepository) FindByEmail(db *gorm.DB, email string) (*models.Student, error) {
+	var student models.Student
+	if err := db.Where("email = ?", email).First(&student).Error; err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
+
+// ExistsByEmail 判断email是否已被使用
+func (r *StudentRepository) ExistsByEmail(db *gorm.DB, email string) (bool, error) {
+	var count int64
+	if err := db.Model(&models.Student{}).Where("email = ?", email).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// List 按过滤/排序/分页条件查询学生列表及总数
+func (r *StudentRepository) List(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, int64, error) {
+	var students []models.Student
+	var total int64
+
+	scope := applyStudentFilters(db.Model(&models.Student{}), query)
+
+	if err := scope.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := scope.Order(query.OrderClause()).
+		Offset(query.Offset()).
+		Limit(query.PageSize).
+		Find(&students).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return students, total, nil
+}
+
+// FindFiltered 按过滤条件查询学生列表，不分页，用于导出当前筛选结果
+func (r *StudentRepository) FindFiltered(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, error) {
+	var students []models.Student
+	scope := applyStudentFilters(db.Model(&models.Student{}), query)
+	err := scope.Order(query.OrderClause()).Find(&students).Error
+	return students, err
+}
+
+// FindTrashed 列出已软删除的学生
+func (r *StudentRepository) FindTrashed(db *gorm.DB) ([]models.Student, error) {
+	var students []models.Student
+	err := db.Unscoped().Where("deleted_at IS NOT NULL").Find(&students).Error
+	return students, err
+}
+
+// FindTrashedByID 根据ID查找已软删除的学生
+func (r *StudentRepository) FindTrashedByID(db *gorm.DB, id uint) (*models.Student, error) {
+	var student models.Student
+	err := db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&student).Error
+	if err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
+
+// Restore 清除学生的deleted_at，恢复记录
+func (r *StudentRepository) Restore(db *gorm.DB, id uint) error {
+	return db.Unscoped().Model(&models.Student{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// applyStudentFilters 在查询上叠加dto.StudentQuery中的过滤条件
+// GORM已经对Student应用了软删除scope，不需要再手动加 deleted_at IS NULL
+func applyStudentFilters(scope *gorm.DB, query *dto.StudentQuery) *gorm.DB {
+	if query.Name != "" {
+		scope = scope.Where("name LIKE ?", "%"+query.Name+"%")
+	}
+	if query.Major != "" {
+		scope = scope.Where("major LIKE ?", "%"+query.Major+"%")
+	}
+	if query.Grade != "" {
+		scope = scope.Where("grade = ?", query.Grade)
+	}
+	if query.Gender != "" {
+		scope = scope.Where("gender = ?", query.Gender)
+	}
+	if query.AgeMin > 0 {
+		scope = scope.Where("age >= ?", query.AgeMin)
+	}
+	if query.AgeMax > 0 {
+		scope = scope.Where("age <= ?", query.AgeMax)
+	}
+	if query.Q != "" {
+		like := "%" + query.Q + "%"
+		scope = scope.Where("name LIKE ? OR email LIKE ? OR major LIKE ?", like, like, like)
+	}
+	return scope
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"student-management-system/models"
+
+	"gorm.io/gorm"
+)
+
+// UserRepository 封装对users表的数据访问
+type UserRepository struct{}
+
+// NewUserRepository 创建UserRepository
+func NewUserRepository() *UserRepository {
+	return &UserRepository{}
+}
+
+// FindByID 根据ID查找用户
+func (r *UserRepository) FindByID(db *gorm.DB, id uint) (*models.User, error) {
+	var user models.User
+	if err := db.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -2,57 +2,102 @@ package routes
 
 import (
 	"student-management-system/controllers"
+	"student-management-system/middleware"
+	"student-management-system/models"
 
 	"github.com/gin-gonic/gin"
 )
 
-// SetupRoutes 设置路由
-func SetupRoutes(router *gin.Engine) {
-	studentController := &controllers.StudentController{}
-	
+// SetupRoutes 设置路由，studentController/auditController由main.go注入依赖后传入
+func SetupRoutes(router *gin.Engine, studentController *controllers.StudentController, auditController *controllers.AuditController) {
+	authController := &controllers.AuthController{}
+
 	// API路由组
 	api := router.Group("/api/v1")
 	{
-		// 学生相关路由
+		// 认证相关路由
+		auth := api.Group("/auth")
+		{
+			// POST /api/v1/auth/register - 注册
+			auth.POST("/register", authController.Register)
+
+			// POST /api/v1/auth/login - 登录
+			auth.POST("/login", authController.Login)
+
+			// POST /api/v1/auth/refresh - 刷新token
+			auth.POST("/refresh", authController.Refresh)
+
+			// POST /api/v1/auth/logout - 登出
+			auth.POST("/logout", middleware.JWTAuth(), authController.Logout)
+
+			// POST /api/v1/auth/users - 管理员创建账号（可指定admin/teacher/student角色），仅admin
+			auth.POST("/users", middleware.JWTAuth(), middleware.RequireRole(models.RoleAdmin), authController.CreateUser)
+		}
+
+		// 学生相关路由，登录后可读，创建/更新/删除需要admin或teacher角色
 		students := api.Group("/students")
+		students.Use(middleware.JWTAuth())
 		{
-			// GET /api/v1/students - 获取所有学生
+			// GET /api/v1/students - 获取学生列表（支持page/page_size/sort及name/major/grade/gender/age_min/age_max/q过滤）
 			students.GET("", studentController.GetAllStudents)
-			
-			// GET /api/v1/students/search - 搜索学生
-			students.GET("/search", studentController.SearchStudents)
-			
-			// GET /api/v1/students/:id - 根据ID获取学生
+
+			// GET /api/v1/students/export - 导出学生列表(csv/xlsx)
+			students.GET("/export", studentController.ExportStudents)
+
+			// GET /api/v1/students/:id - 根据ID获取学生（student角色只能查看自己关联的记录）
 			students.GET("/:id", studentController.GetStudentByID)
-			
+
 			// POST /api/v1/students - 创建新学生
-			students.POST("", studentController.CreateStudent)
-			
+			students.POST("", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.CreateStudent)
+
+			// POST /api/v1/students/bulk - 批量创建学生
+			students.POST("/bulk", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.BulkCreateStudents)
+
+			// POST /api/v1/students/import - 从CSV/XLSX文件导入学生
+			students.POST("/import", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.ImportStudents)
+
 			// PUT /api/v1/students/:id - 更新学生信息
-			students.PUT("/:id", studentController.UpdateStudent)
-			
+			students.PUT("/:id", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.UpdateStudent)
+
 			// DELETE /api/v1/students/:id - 删除学生
-			students.DELETE("/:id", studentController.DeleteStudent)
+			students.DELETE("/:id", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.DeleteStudent)
+
+			// DELETE /api/v1/students/bulk - 批量删除学生
+			students.DELETE("/bulk", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.BulkDeleteStudents)
+
+			// GET /api/v1/students/trash - 查看已软删除的学生（仅admin/teacher）
+			students.GET("/trash", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.GetTrashedStudents)
+
+			// POST /api/v1/students/:id/restore - 恢复已软删除的学生（仅admin/teacher）
+			students.POST("/:id/restore", middleware.RequireRole(models.RoleAdmin, models.RoleTeacher), studentController.RestoreStudent)
+		}
+
+		// 审计日志查询，仅admin可查看
+		audit := api.Group("/audit")
+		audit.Use(middleware.JWTAuth(), middleware.RequireRole(models.RoleAdmin))
+		{
+			// GET /api/v1/audit - 按entity/entity_id查询审计日志
+			audit.GET("", auditController.GetAuditLogs)
 		}
 	}
-	
+
 	// 静态文件服务
 	router.Static("/static", "./views/static")
-	
+
 	// HTML模板路由
 	router.LoadHTMLGlob("views/templates/*")
-	
+
 	// 前端页面路由
 	router.GET("/", func(c *gin.Context) {
 		c.HTML(200, "index.html", gin.H{
 			"title": "学生管理系统",
 		})
 	})
-	
+
 	// 学生管理页面
 	router.GET("/students", func(c *gin.Context) {
 		c.HTML(200, "students.html", gin.H{
 			"title": "学生管理",
 		})
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"encoding/json"
+
+	"student-management-system/models"
+	"student-management-system/repositories"
+
+	"gorm.io/gorm"
+)
+
+// AuditService 记录业务实体的增删改历史
+type AuditService struct {
+	db   *gorm.DB
+	repo repositories.AuditLogRepo
+}
+
+// NewAuditService 创建AuditService
+func NewAuditService(db *gorm.DB, repo repositories.AuditLogRepo) *AuditService {
+	return &AuditService{db: db, repo: repo}
+}
+
+// Record 在tx中写入一条审计日志，before/after会被序列化为JSON
+func (s *AuditService) Record(tx *gorm.DB, actorUserID uint, action, entity string, entityID uint, before, after interface{}, ip string) error {
+	beforeJSON, _ := json.Marshal(before)
+	afterJSON, _ := json.Marshal(after)
+
+	log := models.AuditLog{
+		ActorUserID: actorUserID,
+		Action:      action,
+		Entity:      entity,
+		EntityID:    entityID,
+		Before:      string(beforeJSON),
+		After:       string(afterJSON),
+		IP:          ip,
+	}
+	return s.repo.Create(tx, &log)
+}
+
+// ListByEntity 查询某实体的审计历史
+func (s *AuditService) ListByEntity(entity string, entityID uint) ([]models.AuditLog, error) {
+	return s.repo.ListByEntity(s.db, entity, entityID)
+}
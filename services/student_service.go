@@ -0,0 +1,241 @@
+package services
+
+import (
+	"student-management-system/dto"
+	"student-management-system/models"
+	"student-management-system/pkg/errs"
+	"student-management-system/repositories"
+
+	"gorm.io/gorm"
+)
+
+// RowError 批量/导入操作中单行数据的错误
+type RowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// studentEntity 审计日志中记录的实体名
+const studentEntity = "student"
+
+// StudentService 封装学生相关的业务逻辑，controller不再直接操作数据库
+type StudentService struct {
+	db    *gorm.DB
+	repo  repositories.StudentRepo
+	audit *AuditService
+}
+
+// NewStudentService 创建StudentService
+func NewStudentService(db *gorm.DB, repo repositories.StudentRepo, audit *AuditService) *StudentService {
+	return &StudentService{db: db, repo: repo, audit: audit}
+}
+
+// List 按过滤/排序/分页条件查询学生列表及总数
+func (s *StudentService) List(query *dto.StudentQuery) ([]models.Student, int64, error) {
+	query.Normalize()
+	return s.repo.List(s.db, query)
+}
+
+// GetByID 根据ID查找学生
+func (s *StudentService) GetByID(id uint) (*models.Student, error) {
+	return s.repo.FindByID(s.db, id)
+}
+
+// Create 创建学生，并记录审计日志；email已被占用时返回409而不是让底层唯一索引报错
+func (s *StudentService) Create(student *models.Student, actorID uint, ip string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		exists, err := s.repo.ExistsByEmail(tx, student.Email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errs.ErrConflict("Email已被使用")
+		}
+
+		if err := s.repo.Create(tx, student); err != nil {
+			return err
+		}
+		return s.audit.Record(tx, actorID, models.AuditActionCreate, studentEntity, student.ID, nil, student, ip)
+	})
+}
+
+// Update 更新学生信息，返回更新后的记录，并记录审计日志
+func (s *StudentService) Update(id uint, updates models.Student, actorID uint, ip string) (*models.Student, error) {
+	var updated *models.Student
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		student, err := s.repo.FindByID(tx, id)
+		if err != nil {
+			return err
+		}
+		before := *student
+
+		if err := s.repo.Update(tx, student, updates); err != nil {
+			return err
+		}
+		updated = student
+
+		return s.audit.Record(tx, actorID, models.AuditActionUpdate, studentEntity, student.ID, before, student, ip)
+	})
+	return updated, err
+}
+
+// Delete 软删除学生，并记录审计日志
+func (s *StudentService) Delete(id uint, actorID uint, ip string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		student, err := s.repo.FindByID(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Delete(tx, student); err != nil {
+			return err
+		}
+		return s.audit.Record(tx, actorID, models.AuditActionDelete, studentEntity, student.ID, student, nil, ip)
+	})
+}
+
+// BulkDelete 按ID批量软删除学生，返回受影响行数，只对实际存在的ID记录审计日志
+func (s *StudentService) BulkDelete(ids []uint, actorID uint, ip string) (int64, error) {
+	var affected int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		existing, err := s.repo.FindExistingIDs(tx, ids)
+		if err != nil {
+			return err
+		}
+		if len(existing) == 0 {
+			return nil
+		}
+
+		affected, err = s.repo.DeleteByIDs(tx, existing)
+		if err != nil {
+			return err
+		}
+		for _, id := range existing {
+			if err := s.audit.Record(tx, actorID, models.AuditActionDelete, studentEntity, id, nil, nil, ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return affected, err
+}
+
+// BulkCreate 批量创建学生，逐行校验格式及email重复（含批内重复），返回成功数量和行级错误，并记录审计日志
+func (s *StudentService) BulkCreate(students []models.Student, actorID uint, ip string) (int, []RowError, error) {
+	var valid []models.Student
+	var rowErrors []RowError
+	seenEmails := make(map[string]bool, len(students))
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for i, student := range students {
+			if msg := validateStudent(&student); msg != "" {
+				rowErrors = append(rowErrors, RowError{Row: i + 1, Message: msg})
+				continue
+			}
+			if seenEmails[student.Email] {
+				rowErrors = append(rowErrors, RowError{Row: i + 1, Message: "Email已被使用"})
+				continue
+			}
+			exists, err := s.repo.ExistsByEmail(tx, student.Email)
+			if err != nil {
+				return err
+			}
+			if exists {
+				rowErrors = append(rowErrors, RowError{Row: i + 1, Message: "Email已被使用"})
+				continue
+			}
+			seenEmails[student.Email] = true
+			valid = append(valid, student)
+		}
+
+		if len(valid) == 0 {
+			return nil
+		}
+
+		if err := s.repo.CreateInBatches(tx, valid, 100); err != nil {
+			return err
+		}
+		for _, student := range valid {
+			if err := s.audit.Record(tx, actorID, models.AuditActionCreate, studentEntity, student.ID, nil, student, ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, rowErrors, err
+	}
+
+	return len(valid), rowErrors, nil
+}
+
+// Import 从解析好的行数据导入学生，按email去重（已存在则更新，否则创建），并记录审计日志
+func (s *StudentService) Import(rows []map[string]string, actorID uint, ip string) (inserted, updated, skipped int, rowErrors []RowError, err error) {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for i, row := range rows {
+			student, msg := rowFromImport(row)
+			if msg != "" {
+				rowErrors = append(rowErrors, RowError{Row: i + 2, Message: msg})
+				skipped++
+				continue
+			}
+
+			existing, findErr := s.repo.FindByEmail(tx, student.Email)
+			switch {
+			case findErr == nil:
+				before := *existing
+				if err := s.repo.Update(tx, existing, student); err != nil {
+					return err
+				}
+				if err := s.audit.Record(tx, actorID, models.AuditActionUpdate, studentEntity, existing.ID, before, existing, ip); err != nil {
+					return err
+				}
+				updated++
+			case findErr == gorm.ErrRecordNotFound:
+				if err := s.repo.Create(tx, &student); err != nil {
+					return err
+				}
+				if err := s.audit.Record(tx, actorID, models.AuditActionCreate, studentEntity, student.ID, nil, student, ip); err != nil {
+					return err
+				}
+				inserted++
+			default:
+				return findErr
+			}
+		}
+		return nil
+	})
+	return inserted, updated, skipped, rowErrors, err
+}
+
+// ExportAll 按过滤条件导出当前筛选结果，不分页
+func (s *StudentService) ExportAll(query *dto.StudentQuery) ([]models.Student, error) {
+	return s.repo.FindFiltered(s.db, query)
+}
+
+// ListTrashed 列出已软删除的学生
+func (s *StudentService) ListTrashed() ([]models.Student, error) {
+	return s.repo.FindTrashed(s.db)
+}
+
+// Restore 恢复一条已软删除的学生记录，并记录审计日志
+func (s *StudentService) Restore(id uint, actorID uint, ip string) (*models.Student, error) {
+	var restored *models.Student
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		trashed, err := s.repo.FindTrashedByID(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Restore(tx, id); err != nil {
+			return err
+		}
+
+		student, err := s.repo.FindByID(tx, id)
+		if err != nil {
+			return err
+		}
+		restored = student
+
+		return s.audit.Record(tx, actorID, models.AuditActionRestore, studentEntity, id, trashed, student, ip)
+	})
+	return restored, err
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"testing"
+
+	"student-management-system/dto"
+	"student-management-system/models"
+	"student-management-system/pkg/errs"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// mockStudentRepo 实现repositories.StudentRepo，使StudentService可以在不连接真实数据库的情况下测试
+type mockStudentRepo struct {
+	existsByEmail      func(email string) (bool, error)
+	findExistingIDs    func(ids []uint) ([]uint, error)
+	createCalls        []*models.Student
+	createInBatchesArg []models.Student
+	deleteByIDsCalls   [][]uint
+}
+
+func (m *mockStudentRepo) Create(db *gorm.DB, student *models.Student) error {
+	student.ID = 1
+	m.createCalls = append(m.createCalls, student)
+	return nil
+}
+
+func (m *mockStudentRepo) CreateInBatches(db *gorm.DB, students []models.Student, batchSize int) error {
+	for i := range students {
+		students[i].ID = uint(i + 1)
+	}
+	m.createInBatchesArg = students
+	return nil
+}
+
+func (m *mockStudentRepo) Update(db *gorm.DB, student *models.Student, updates models.Student) error {
+	return nil
+}
+
+func (m *mockStudentRepo) Delete(db *gorm.DB, student *models.Student) error { return nil }
+
+func (m *mockStudentRepo) DeleteByIDs(db *gorm.DB, ids []uint) (int64, error) {
+	m.deleteByIDsCalls = append(m.deleteByIDsCalls, ids)
+	return int64(len(ids)), nil
+}
+
+func (m *mockStudentRepo) FindExistingIDs(db *gorm.DB, ids []uint) ([]uint, error) {
+	return m.findExistingIDs(ids)
+}
+
+func (m *mockStudentRepo) FindByID(db *gorm.DB, id uint) (*models.Student, error) { return nil, nil }
+
+func (m *mockStudentRepo) FindByEmail(db *gorm.DB, email string) (*models.Student, error) {
+	return nil, nil
+}
+
+func (m *mockStudentRepo) ExistsByEmail(db *gorm.DB, email string) (bool, error) {
+	return m.existsByEmail(email)
+}
+
+func (m *mockStudentRepo) List(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *mockStudentRepo) FindFiltered(db *gorm.DB, query *dto.StudentQuery) ([]models.Student, error) {
+	return nil, nil
+}
+
+func (m *mockStudentRepo) FindTrashed(db *gorm.DB) ([]models.Student, error) { return nil, nil }
+
+func (m *mockStudentRepo) FindTrashedByID(db *gorm.DB, id uint) (*models.Student, error) {
+	return nil, nil
+}
+
+func (m *mockStudentRepo) Restore(db *gorm.DB, id uint) error { return nil }
+
+// mockAuditRepo 实现repositories.AuditLogRepo，用于断言StudentService是否正确写入审计日志
+type mockAuditRepo struct {
+	createCalls []*models.AuditLog
+}
+
+func (m *mockAuditRepo) Create(db *gorm.DB, log *models.AuditLog) error {
+	m.createCalls = append(m.createCalls, log)
+	return nil
+}
+
+func (m *mockAuditRepo) ListByEntity(db *gorm.DB, entity string, entityID uint) ([]models.AuditLog, error) {
+	return nil, nil
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open in-memory db: %v", err)
+	}
+	return db
+}
+
+func TestStudentServiceCreateDuplicateEmailReturnsConflict(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		existsByEmail: func(email string) (bool, error) { return true, nil },
+	}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), &mockAuditRepo{}))
+
+	err := svc.Create(&models.Student{Email: "dup@example.com"}, 1, "127.0.0.1")
+
+	appErr, ok := err.(*errs.AppError)
+	if !ok {
+		t.Fatalf("expected *errs.AppError, got %T (%v)", err, err)
+	}
+	if appErr.Code != errs.CodeConflict {
+		t.Fatalf("expected conflict code %d, got %d", errs.CodeConflict, appErr.Code)
+	}
+	if len(studentRepo.createCalls) != 0 {
+		t.Fatalf("Create should not be called when email already exists")
+	}
+}
+
+func TestStudentServiceCreateRecordsAuditLog(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		existsByEmail: func(email string) (bool, error) { return false, nil },
+	}
+	auditRepo := &mockAuditRepo{}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), auditRepo))
+
+	student := &models.Student{Email: "new@example.com", Name: "Alice"}
+	if err := svc.Create(student, 7, "127.0.0.1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(studentRepo.createCalls) != 1 {
+		t.Fatalf("expected Create to be called once, got %d", len(studentRepo.createCalls))
+	}
+	if len(auditRepo.createCalls) != 1 {
+		t.Fatalf("expected one audit log entry, got %d", len(auditRepo.createCalls))
+	}
+	if auditRepo.createCalls[0].Action != models.AuditActionCreate {
+		t.Fatalf("expected action %q, got %q", models.AuditActionCreate, auditRepo.createCalls[0].Action)
+	}
+	if auditRepo.createCalls[0].ActorUserID != 7 {
+		t.Fatalf("expected actor 7, got %d", auditRepo.createCalls[0].ActorUserID)
+	}
+}
+
+func TestStudentServiceBulkCreateSkipsRowsWithExistingEmail(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		existsByEmail: func(email string) (bool, error) { return email == "dup@example.com", nil },
+	}
+	auditRepo := &mockAuditRepo{}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), auditRepo))
+
+	students := []models.Student{
+		{Name: "Alice", Email: "dup@example.com", Age: 20, Gender: "女", Phone: "13800000001", Grade: "大一", Major: "CS"},
+		{Name: "Bob", Email: "bob@example.com", Age: 21, Gender: "男", Phone: "13800000002", Grade: "大一", Major: "CS"},
+	}
+
+	inserted, rowErrors, err := svc.BulkCreate(students, 1, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inserted != 1 {
+		t.Fatalf("expected 1 row inserted, got %d", inserted)
+	}
+	if len(rowErrors) != 1 || rowErrors[0].Row != 1 {
+		t.Fatalf("expected a row error on row 1 for the duplicate email, got %+v", rowErrors)
+	}
+	if len(studentRepo.createInBatchesArg) != 1 || studentRepo.createInBatchesArg[0].Email != "bob@example.com" {
+		t.Fatalf("expected only the non-duplicate row to be inserted, got %+v", studentRepo.createInBatchesArg)
+	}
+	if len(auditRepo.createCalls) != 1 {
+		t.Fatalf("expected one audit log entry, got %d", len(auditRepo.createCalls))
+	}
+}
+
+func TestStudentServiceBulkCreateSkipsInBatchDuplicateEmails(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		existsByEmail: func(email string) (bool, error) { return false, nil },
+	}
+	auditRepo := &mockAuditRepo{}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), auditRepo))
+
+	students := []models.Student{
+		{Name: "Alice", Email: "same@example.com", Age: 20, Gender: "女", Phone: "13800000001", Grade: "大一", Major: "CS"},
+		{Name: "Bob", Email: "same@example.com", Age: 21, Gender: "男", Phone: "13800000002", Grade: "大一", Major: "CS"},
+	}
+
+	inserted, rowErrors, err := svc.BulkCreate(students, 1, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inserted != 1 {
+		t.Fatalf("expected 1 row inserted, got %d", inserted)
+	}
+	if len(rowErrors) != 1 || rowErrors[0].Row != 2 {
+		t.Fatalf("expected a row error on row 2 for the in-batch duplicate email, got %+v", rowErrors)
+	}
+}
+
+func TestStudentServiceBulkDeleteOnlyAuditsExistingIDs(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		findExistingIDs: func(ids []uint) ([]uint, error) { return []uint{1}, nil },
+	}
+	auditRepo := &mockAuditRepo{}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), auditRepo))
+
+	affected, err := svc.BulkDelete([]uint{1, 999}, 1, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if affected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", affected)
+	}
+	if len(studentRepo.deleteByIDsCalls) != 1 || len(studentRepo.deleteByIDsCalls[0]) != 1 || studentRepo.deleteByIDsCalls[0][0] != 1 {
+		t.Fatalf("expected DeleteByIDs to be called with only existing id [1], got %v", studentRepo.deleteByIDsCalls)
+	}
+	if len(auditRepo.createCalls) != 1 {
+		t.Fatalf("expected one audit log entry for the id that actually existed, got %d", len(auditRepo.createCalls))
+	}
+	if auditRepo.createCalls[0].EntityID != 1 {
+		t.Fatalf("expected audit entry for entity id 1, got %d", auditRepo.createCalls[0].EntityID)
+	}
+}
+
+func TestStudentServiceBulkDeleteNoExistingIDsSkipsDelete(t *testing.T) {
+	studentRepo := &mockStudentRepo{
+		findExistingIDs: func(ids []uint) ([]uint, error) { return nil, nil },
+	}
+	auditRepo := &mockAuditRepo{}
+	svc := NewStudentService(newTestDB(t), studentRepo, NewAuditService(newTestDB(t), auditRepo))
+
+	affected, err := svc.BulkDelete([]uint{999}, 1, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if affected != 0 {
+		t.Fatalf("expected 0 rows affected, got %d", affected)
+	}
+	if len(studentRepo.deleteByIDsCalls) != 0 {
+		t.Fatalf("DeleteByIDs should not be called when no ids exist")
+	}
+	if len(auditRepo.createCalls) != 0 {
+		t.Fatalf("no audit entries should be written when nothing was deleted")
+	}
+}
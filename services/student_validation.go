@@ -0,0 +1,43 @@
+package services
+
+import (
+	"strconv"
+
+	"student-management-system/models"
+)
+
+// validateStudent 校验学生必填字段，返回第一条错误信息
+func validateStudent(s *models.Student) string {
+	switch {
+	case s.Name == "":
+		return "name不能为空"
+	case s.Age <= 0 || s.Age > 150:
+		return "age必须在1-150之间"
+	case s.Gender != "男" && s.Gender != "女":
+		return "gender必须为 男 或 女"
+	case s.Email == "":
+		return "email不能为空"
+	case s.Phone == "":
+		return "phone不能为空"
+	case s.Major == "":
+		return "major不能为空"
+	case s.Grade == "":
+		return "grade不能为空"
+	}
+	return ""
+}
+
+// rowFromImport 将导入行转换为Student并做基础校验
+func rowFromImport(row map[string]string) (models.Student, string) {
+	age, _ := strconv.Atoi(row["age"])
+	student := models.Student{
+		Name:   row["name"],
+		Age:    age,
+		Gender: row["gender"],
+		Email:  row["email"],
+		Phone:  row["phone"],
+		Major:  row["major"],
+		Grade:  row["grade"],
+	}
+	return student, validateStudent(&student)
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"student-management-system/models"
+	"student-management-system/repositories"
+
+	"gorm.io/gorm"
+)
+
+// UserService 封装用户相关的业务逻辑
+type UserService struct {
+	db   *gorm.DB
+	repo *repositories.UserRepository
+}
+
+// NewUserService 创建UserService
+func NewUserService(db *gorm.DB, repo *repositories.UserRepository) *UserService {
+	return &UserService{db: db, repo: repo}
+}
+
+// GetByID 根据ID查找用户
+func (s *UserService) GetByID(id uint) (*models.User, error) {
+	return s.repo.FindByID(s.db, id)
+}